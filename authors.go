@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/GuadalupePradoSR/Book-Library-API/store"
+)
+
+// função para cadastrar um novo autor.
+func createAuthor(c *gin.Context) {
+	var newAuthor store.Author
+	if err := c.BindJSON(&newAuthor); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+
+	created, err := st.CreateAuthor(newAuthor)
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "ID do autor já existe"})
+			return
+		}
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao adicionar autor: %v", err)})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, created)
+}
+
+// função para retornar todos os autores.
+func getAuthors(c *gin.Context) {
+	authors, err := st.GetAllAuthors()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao buscar autores"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, authors)
+}
+
+// função para buscar um autor pelo ID.
+func getAuthor(c *gin.Context) {
+	a, err := st.GetAuthor(c.Param("id"))
+	if err != nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Autor não encontrado"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, a)
+}
+
+// função para atualizar os dados de um autor existente.
+func updateAuthor(c *gin.Context) {
+	var a store.Author
+	if err := c.BindJSON(&a); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+	a.ID = c.Param("id")
+
+	if err := st.UpdateAuthor(a); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Autor não encontrado"})
+			return
+		}
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao atualizar autor: %v", err)})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, a)
+}
+
+// função para remover um autor.
+func deleteAuthor(c *gin.Context) {
+	if err := st.DeleteAuthor(c.Param("id")); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Autor não encontrado"})
+		case errors.Is(err, store.ErrReferenced):
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "Autor ainda referenciado por um livro"})
+		default:
+			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao remover autor: %v", err)})
+		}
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
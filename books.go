@@ -0,0 +1,305 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/GuadalupePradoSR/Book-Library-API/query"
+	"github.com/GuadalupePradoSR/Book-Library-API/store"
+)
+
+// book é o modelo de um livro exposto pela API, já com Authors e Publisher
+// hidratados na leitura.
+type book struct {
+	ID          string           `json:"id"`
+	Title       string           `json:"title"`
+	Quantity    int              `json:"quantity"`
+	PublisherID string           `json:"publisher_id,omitempty"`
+	Publisher   *store.Publisher `json:"publisher,omitempty"`
+	AuthorIDs   []string         `json:"author_ids,omitempty"`
+	Authors     []store.Author   `json:"authors,omitempty"`
+	ISBN        string           `json:"isbn,omitempty"`
+	Pages       int              `json:"pages,omitempty"`
+	CoverURL    string           `json:"cover_url,omitempty"`
+	Description string           `json:"description,omitempty"`
+}
+
+// createBookRequest é o corpo aceito por createBook: autores podem ser
+// referenciados por ID (AuthorIDs) e/ou enviados inline em Authors, que são
+// criados automaticamente quando o ID ainda não existir.
+type createBookRequest struct {
+	ID          string         `json:"id"`
+	Title       string         `json:"title"`
+	Quantity    int            `json:"quantity"`
+	PublisherID string         `json:"publisher_id"`
+	AuthorIDs   []string       `json:"author_ids"`
+	Authors     []store.Author `json:"authors"`
+	ISBN        string         `json:"isbn"`
+	Pages       int            `json:"pages"`
+	CoverURL    string         `json:"cover_url"`
+	Description string         `json:"description"`
+}
+
+func toBook(b store.Book) book {
+	return book{
+		ID:          b.ID,
+		Title:       b.Title,
+		Quantity:    b.Quantity,
+		PublisherID: b.PublisherID,
+		Publisher:   b.Publisher,
+		AuthorIDs:   b.AuthorIDs,
+		Authors:     b.Authors,
+		ISBN:        b.ISBN,
+		Pages:       b.Pages,
+		CoverURL:    b.CoverURL,
+		Description: b.Description,
+	}
+}
+
+// resolveAuthorIDs garante que cada autor inline exista na Store (criando os
+// que faltarem) e retorna a lista combinada de IDs de autor para o livro.
+func resolveAuthorIDs(req createBookRequest) ([]string, error) {
+	authorIDs := append([]string{}, req.AuthorIDs...)
+
+	for _, inline := range req.Authors {
+		if _, err := st.GetAuthor(inline.ID); err != nil {
+			if !errors.Is(err, store.ErrNotFound) {
+				return nil, err
+			}
+			if _, err := st.CreateAuthor(inline); err != nil {
+				return nil, err
+			}
+		}
+		authorIDs = append(authorIDs, inline.ID)
+	}
+
+	return authorIDs, nil
+}
+
+// função para adicionar um novo livro à base de dados.
+func createBook(c *gin.Context) {
+	var req createBookRequest
+	if err := c.BindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+
+	authorIDs, err := resolveAuthorIDs(req)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao resolver autores: %v", err)})
+		return
+	}
+
+	newBook := store.Book{
+		ID:          req.ID,
+		Title:       req.Title,
+		Quantity:    req.Quantity,
+		PublisherID: req.PublisherID,
+		AuthorIDs:   authorIDs,
+		ISBN:        req.ISBN,
+		Pages:       req.Pages,
+		CoverURL:    req.CoverURL,
+		Description: req.Description,
+	}
+
+	created, err := st.Create(newBook)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrConflict):
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "ID do livro já existe"})
+		case errors.Is(err, store.ErrInvalidReference):
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Autor ou editora referenciados não existem"})
+		default:
+			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao adicionar livro: %v", err)})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, toBook(created))
+}
+
+// função para retornar todos os livros.
+func getBooks(c *gin.Context) {
+	books, err := st.GetAll()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao buscar livros"})
+		return
+	}
+
+	result := make([]book, 0, len(books))
+	for _, b := range books {
+		result = append(result, toBook(b))
+	}
+	c.IndentedJSON(http.StatusOK, result)
+}
+
+// função para buscar um livro pelo ID.
+func getBookById(id string) (*book, error) {
+	b, err := st.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	result := toBook(b)
+	return &result, nil
+}
+
+func getBookByIdHandler(c *gin.Context) {
+	id := c.Param("id")
+	b, err := getBookById(id)
+	if err != nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Livro não encontrado"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, b)
+}
+
+// função para checkout de um livro: abre um empréstimo para o usuário,
+// respeitando o limite de empréstimos simultâneos e a disponibilidade do livro.
+func checkoutBook(c *gin.Context) {
+	var request struct {
+		UserID string `json:"user_id"`
+		BookID string `json:"book_id"`
+	}
+	if err := c.BindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+
+	if request.UserID == "" || request.BookID == "" {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "user_id e book_id são necessários"})
+		return
+	}
+
+	due := time.Now().UTC().Add(loanDuration)
+	loan, err := st.Checkout(request.UserID, request.BookID, maxActiveLoansPerUser, due)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Livro não encontrado"})
+		case errors.Is(err, store.ErrNoStock):
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "Livro não disponível"})
+		case errors.Is(err, store.ErrLoanCapExceeded):
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "Limite de empréstimos simultâneos atingido"})
+		default:
+			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao realizar checkout"})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, loan)
+}
+
+// função para retorno de um livro, a partir do ID do empréstimo.
+func returnBook(c *gin.Context) {
+	var request struct {
+		LoanID string `json:"loan_id"`
+	}
+	if err := c.BindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+
+	if request.LoanID == "" {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "loan_id é necessário"})
+		return
+	}
+
+	loan, err := st.Return(request.LoanID)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Empréstimo não encontrado"})
+		case errors.Is(err, store.ErrAlreadyReturned):
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "Empréstimo já foi devolvido"})
+		default:
+			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao retornar livro"})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, loan)
+}
+
+// bookFromLookup monta um book a partir dos metadados retornados pelo
+// provedor externo, sem autores/editora ainda resolvidos para IDs locais.
+func bookFromLookup(info query.BookInfo) book {
+	return book{
+		ID:          info.ISBN,
+		Title:       info.Title,
+		ISBN:        info.ISBN,
+		Pages:       info.Pages,
+		CoverURL:    info.CoverURL,
+		Description: info.Description,
+	}
+}
+
+// função para buscar metadados de um livro por ISBN na Google Books API
+// (GET /books/lookup?isbn=...), persistindo o resultado quando ?save=true.
+func lookupBook(c *gin.Context) {
+	isbn := c.Query("isbn")
+	if isbn == "" {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "isbn é necessário"})
+		return
+	}
+
+	info, err := gbClient.LookupISBN(c.Request.Context(), isbn)
+	if err != nil {
+		if errors.Is(err, query.ErrNotFound) {
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Nenhum livro encontrado para o ISBN informado"})
+			return
+		}
+		c.IndentedJSON(http.StatusBadGateway, gin.H{"message": fmt.Sprintf("Erro ao consultar Google Books: %v", err)})
+		return
+	}
+
+	result := bookFromLookup(info)
+
+	if c.Query("save") == "true" {
+		var publisherID string
+		if info.Publisher != "" {
+			publisher, err := st.CreatePublisher(store.Publisher{ID: uuid.NewString(), Name: info.Publisher})
+			if err != nil {
+				c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao salvar editora: %v", err)})
+				return
+			}
+			publisherID = publisher.ID
+		}
+
+		authorIDs := make([]string, 0, len(info.Authors))
+		for _, name := range info.Authors {
+			author, err := st.CreateAuthor(store.Author{ID: uuid.NewString(), Name: name})
+			if err != nil {
+				c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao salvar autor: %v", err)})
+				return
+			}
+			authorIDs = append(authorIDs, author.ID)
+		}
+
+		created, err := st.Create(store.Book{
+			ID:          info.ISBN,
+			Title:       info.Title,
+			PublisherID: publisherID,
+			AuthorIDs:   authorIDs,
+			ISBN:        info.ISBN,
+			Pages:       info.Pages,
+			CoverURL:    info.CoverURL,
+			Description: info.Description,
+		})
+		if err != nil {
+			if errors.Is(err, store.ErrConflict) {
+				c.IndentedJSON(http.StatusConflict, gin.H{"message": "ID do livro já existe"})
+				return
+			}
+			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao adicionar livro: %v", err)})
+			return
+		}
+		result = toBook(created)
+	}
+
+	c.IndentedJSON(http.StatusOK, result)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/GuadalupePradoSR/Book-Library-API/query"
+	"github.com/GuadalupePradoSR/Book-Library-API/store"
+)
+
+// stubBooksLookupper é um query.BooksLookupper de teste que devolve info ou
+// err sem bater em rede.
+type stubBooksLookupper struct {
+	info query.BookInfo
+	err  error
+}
+
+func (s stubBooksLookupper) LookupISBN(ctx context.Context, isbn string) (query.BookInfo, error) {
+	return s.info, s.err
+}
+
+func TestLookupBookNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	st, _ = store.New("memory", "")
+	gbClient = stubBooksLookupper{err: query.ErrNotFound}
+
+	router := gin.New()
+	router.GET("/books/lookup", lookupBook)
+
+	req := httptest.NewRequest(http.MethodGet, "/books/lookup?isbn=0000000000", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestLookupBookSavesWhenRequested(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	st, _ = store.New("memory", "")
+	gbClient = stubBooksLookupper{info: query.BookInfo{
+		ISBN:      "9788576572128",
+		Title:     "Duna",
+		Authors:   []string{"Frank Herbert"},
+		Publisher: "Aleph",
+		Pages:     688,
+	}}
+
+	router := gin.New()
+	router.GET("/books/lookup", lookupBook)
+
+	req := httptest.NewRequest(http.MethodGet, "/books/lookup?isbn=9788576572128&save=true", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	saved, err := st.Get("9788576572128")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if saved.Title != "Duna" || len(saved.Authors) != 1 || saved.Authors[0].Name != "Frank Herbert" {
+		t.Fatalf("got %+v, want Duna by Frank Herbert persisted", saved)
+	}
+}
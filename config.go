@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"strconv"
+)
+
+// config reúne os parâmetros de execução do servidor, resolvidos a partir de
+// flags de linha de comando com fallback para variáveis de ambiente (nessa
+// ordem de precedência) e, por fim, um valor padrão.
+type config struct {
+	// Addr é o endereço em que o servidor HTTP (Gin) escuta.
+	Addr string
+	// GRPCAddr é o endereço em que o serviço gRPC escuta.
+	GRPCAddr string
+	// StoreProvider seleciona o backend de persistência registrado em store.Register.
+	StoreProvider string
+	// StoreDSN é a string de conexão repassada à Factory do provider (ex.: caminho do arquivo SQLite).
+	StoreDSN string
+	// LogLevel controla o nível mínimo logado (debug, info, warn, error).
+	LogLevel string
+	// MaxActiveLoansPerUser é o limite de empréstimos simultâneos em aberto por usuário.
+	MaxActiveLoansPerUser int
+	// LoanDurationDays é o prazo padrão, em dias, de devolução a partir do checkout.
+	LoanDurationDays int
+}
+
+// loadConfig lê a configuração de flags/env. Flags explícitas têm prioridade
+// sobre as variáveis de ambiente correspondentes.
+func loadConfig(args []string) (config, error) {
+	fs := flag.NewFlagSet("book-library-api", flag.ContinueOnError)
+
+	addr := fs.String("addr", envOrDefault("ADDR", "localhost:8080"), "endereço do servidor HTTP")
+	grpcAddr := fs.String("grpc-addr", envOrDefault("GRPC_ADDR", ":50051"), "endereço do servidor gRPC")
+	storeProvider := fs.String("store-provider", envOrDefault("STORE_PROVIDER", "sqlite"), "provider de persistência (sqlite, memory)")
+	storeDSN := fs.String("store-dsn", envOrDefault("STORE_DSN", "./books.db"), "DSN repassado ao provider de persistência")
+	logLevel := fs.String("log-level", envOrDefault("LOG_LEVEL", "info"), "nível mínimo de log (debug, info, warn, error)")
+	maxActiveLoansPerUser := fs.Int("max-active-loans-per-user", envOrDefaultInt("MAX_ACTIVE_LOANS_PER_USER", 4), "limite de empréstimos simultâneos em aberto por usuário")
+	loanDurationDays := fs.Int("loan-duration-days", envOrDefaultInt("LOAN_DURATION_DAYS", 14), "prazo padrão, em dias, de devolução a partir do checkout")
+
+	if err := fs.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	return config{
+		Addr:                  *addr,
+		GRPCAddr:              *grpcAddr,
+		StoreProvider:         *storeProvider,
+		StoreDSN:              *storeDSN,
+		LogLevel:              *logLevel,
+		MaxActiveLoansPerUser: *maxActiveLoansPerUser,
+		LoanDurationDays:      *loanDurationDays,
+	}, nil
+}
+
+func envOrDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// envOrDefaultInt é o equivalente de envOrDefault para flags numéricas; um
+// valor de ambiente que não parseia como inteiro é ignorado em favor de def.
+func envOrDefaultInt(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return def
+	}
+	return n
+}
@@ -0,0 +1,42 @@
+package main
+
+import "testing"
+
+func TestLoadConfigDefaults(t *testing.T) {
+	cfg, err := loadConfig(nil)
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	if cfg.Addr != "localhost:8080" || cfg.StoreProvider != "sqlite" || cfg.StoreDSN != "./books.db" {
+		t.Fatalf("got %+v, want the documented defaults", cfg)
+	}
+	if cfg.MaxActiveLoansPerUser != 4 || cfg.LoanDurationDays != 14 {
+		t.Fatalf("got %+v, want MaxActiveLoansPerUser=4 LoanDurationDays=14", cfg)
+	}
+}
+
+func TestLoadConfigLoanPolicyOverrides(t *testing.T) {
+	cfg, err := loadConfig([]string{"-max-active-loans-per-user=2", "-loan-duration-days=7"})
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	if cfg.MaxActiveLoansPerUser != 2 || cfg.LoanDurationDays != 7 {
+		t.Fatalf("got %+v, want MaxActiveLoansPerUser=2 LoanDurationDays=7", cfg)
+	}
+}
+
+func TestLoadConfigEnvAndFlagPrecedence(t *testing.T) {
+	t.Setenv("ADDR", "0.0.0.0:9999")
+	t.Setenv("STORE_PROVIDER", "memory")
+
+	cfg, err := loadConfig([]string{"-store-provider=sqlite"})
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	if cfg.Addr != "0.0.0.0:9999" {
+		t.Fatalf("got Addr %q, want the env override to be picked up", cfg.Addr)
+	}
+	if cfg.StoreProvider != "sqlite" {
+		t.Fatalf("got StoreProvider %q, want the explicit flag to win over env", cfg.StoreProvider)
+	}
+}
@@ -0,0 +1,140 @@
+// Package grpcserver expõe a mesma Store usada pela API REST através de um
+// serviço gRPC, para consumidores que preferem um contrato binário a
+// JSON/HTTP (ver proto/books.proto).
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/GuadalupePradoSR/Book-Library-API/pb"
+	"github.com/GuadalupePradoSR/Book-Library-API/store"
+)
+
+// Server implementa pb.BookLibraryServer sobre uma store.Store.
+type Server struct {
+	pb.UnimplementedBookLibraryServer
+
+	st             store.Store
+	maxActiveLoans int
+	loanDuration   time.Duration
+}
+
+// New cria um Server que delega para st, usando maxActiveLoans e loanDuration
+// nas mesmas regras aplicadas pelo checkout via REST.
+func New(st store.Store, maxActiveLoans int, loanDuration time.Duration) *Server {
+	return &Server{st: st, maxActiveLoans: maxActiveLoans, loanDuration: loanDuration}
+}
+
+// storeErrToStatus traduz os erros sentinela de store para códigos gRPC
+// equivalentes aos status HTTP usados pelos handlers REST.
+func storeErrToStatus(err error) error {
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, store.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, store.ErrInvalidReference):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, store.ErrNoStock), errors.Is(err, store.ErrLoanCapExceeded), errors.Is(err, store.ErrAlreadyReturned), errors.Is(err, store.ErrReferenced):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toPBBook(b store.Book) *pb.Book {
+	return &pb.Book{
+		Id:          b.ID,
+		Title:       b.Title,
+		Quantity:    int32(b.Quantity),
+		PublisherId: b.PublisherID,
+		AuthorIds:   b.AuthorIDs,
+		Isbn:        b.ISBN,
+		Pages:       int32(b.Pages),
+		CoverUrl:    b.CoverURL,
+		Description: b.Description,
+	}
+}
+
+func toPBLoan(l store.Loan) *pb.Loan {
+	out := &pb.Loan{
+		Id:         l.ID,
+		UserId:     l.UserID,
+		BookId:     l.BookID,
+		CheckoutAt: l.CheckoutAt.Format(time.RFC3339),
+		DueAt:      l.DueAt.Format(time.RFC3339),
+	}
+	if l.ReturnedAt != nil {
+		out.ReturnedAt = l.ReturnedAt.Format(time.RFC3339)
+	}
+	return out
+}
+
+func (s *Server) CreateBook(ctx context.Context, req *pb.CreateBookRequest) (*pb.Book, error) {
+	created, err := s.st.Create(store.Book{
+		ID:          req.GetId(),
+		Title:       req.GetTitle(),
+		Quantity:    int(req.GetQuantity()),
+		PublisherID: req.GetPublisherId(),
+		AuthorIDs:   req.GetAuthorIds(),
+		ISBN:        req.GetIsbn(),
+		Pages:       int(req.GetPages()),
+		CoverURL:    req.GetCoverUrl(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		return nil, storeErrToStatus(err)
+	}
+	return toPBBook(created), nil
+}
+
+func (s *Server) GetBook(ctx context.Context, req *pb.GetBookRequest) (*pb.Book, error) {
+	b, err := s.st.Get(req.GetId())
+	if err != nil {
+		return nil, storeErrToStatus(err)
+	}
+	return toPBBook(b), nil
+}
+
+func (s *Server) ListBooks(req *pb.ListBooksRequest, stream pb.BookLibrary_ListBooksServer) error {
+	books, err := s.st.GetAll()
+	if err != nil {
+		return storeErrToStatus(err)
+	}
+
+	for _, b := range books {
+		if err := stream.Send(toPBBook(b)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) Checkout(ctx context.Context, req *pb.CheckoutRequest) (*pb.Loan, error) {
+	due := time.Now().UTC().Add(s.loanDuration)
+	loan, err := s.st.Checkout(req.GetUserId(), req.GetBookId(), s.maxActiveLoans, due)
+	if err != nil {
+		return nil, storeErrToStatus(err)
+	}
+	return toPBLoan(loan), nil
+}
+
+func (s *Server) Return(ctx context.Context, req *pb.ReturnRequest) (*pb.Loan, error) {
+	loan, err := s.st.Return(req.GetLoanId())
+	if err != nil {
+		return nil, storeErrToStatus(err)
+	}
+	return toPBLoan(loan), nil
+}
+
+func (s *Server) DeleteBook(ctx context.Context, req *pb.DeleteBookRequest) (*pb.DeleteBookResponse, error) {
+	if err := s.st.Delete(req.GetId()); err != nil {
+		return nil, storeErrToStatus(err)
+	}
+	return &pb.DeleteBookResponse{}, nil
+}
@@ -0,0 +1,189 @@
+package grpcserver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/GuadalupePradoSR/Book-Library-API/pb"
+	"github.com/GuadalupePradoSR/Book-Library-API/store"
+)
+
+// fakeListBooksServer captura os livros enviados por Server.ListBooks sem
+// abrir uma conexão gRPC de verdade.
+type fakeListBooksServer struct {
+	pb.BookLibrary_ListBooksServer
+	books []*pb.Book
+}
+
+func (f *fakeListBooksServer) Send(b *pb.Book) error {
+	f.books = append(f.books, b)
+	return nil
+}
+
+func (f *fakeListBooksServer) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeListBooksServer) SetHeader(metadata.MD) error  { return nil }
+func (f *fakeListBooksServer) SendHeader(metadata.MD) error { return nil }
+func (f *fakeListBooksServer) SetTrailer(metadata.MD)       {}
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	st, err := store.New("memory", "")
+	if err != nil {
+		t.Fatalf("store.New: %v", err)
+	}
+	return New(st, 4, 14*24*time.Hour)
+}
+
+// newTestSQLiteServer é igual a newTestServer, mas sobre o backend de
+// produção (sqlite), para exercitar caminhos que a memoryStore não percorre
+// (ex.: FOREIGN KEY em author_books).
+func newTestSQLiteServer(t *testing.T) *Server {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "grpc.db")
+	st, err := store.New("sqlite", dsn)
+	if err != nil {
+		t.Fatalf("store.New: %v", err)
+	}
+	return New(st, 4, 14*24*time.Hour)
+}
+
+func TestServerCreateAndGetBook(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	created, err := s.CreateBook(ctx, &pb.CreateBookRequest{Id: "book-1", Title: "Duna", Quantity: 2})
+	if err != nil {
+		t.Fatalf("CreateBook: %v", err)
+	}
+	if created.GetTitle() != "Duna" || created.GetQuantity() != 2 {
+		t.Fatalf("got %+v, want Title=Duna Quantity=2", created)
+	}
+
+	got, err := s.GetBook(ctx, &pb.GetBookRequest{Id: "book-1"})
+	if err != nil {
+		t.Fatalf("GetBook: %v", err)
+	}
+	if got.GetId() != "book-1" {
+		t.Fatalf("got ID %q, want book-1", got.GetId())
+	}
+}
+
+func TestServerGetBookNotFound(t *testing.T) {
+	s := newTestServer(t)
+
+	_, err := s.GetBook(context.Background(), &pb.GetBookRequest{Id: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("got code %v, want NotFound", status.Code(err))
+	}
+}
+
+func TestServerCheckoutAndReturn(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	if _, err := s.CreateBook(ctx, &pb.CreateBookRequest{Id: "book-1", Title: "Duna", Quantity: 1}); err != nil {
+		t.Fatalf("CreateBook: %v", err)
+	}
+
+	loan, err := s.Checkout(ctx, &pb.CheckoutRequest{UserId: "user-1", BookId: "book-1"})
+	if err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+
+	if _, err := s.Checkout(ctx, &pb.CheckoutRequest{UserId: "user-2", BookId: "book-1"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("got code %v, want FailedPrecondition (no stock)", status.Code(err))
+	}
+
+	if _, err := s.Return(ctx, &pb.ReturnRequest{LoanId: loan.GetId()}); err != nil {
+		t.Fatalf("Return: %v", err)
+	}
+}
+
+func TestServerListBooksStreamsAll(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	if _, err := s.CreateBook(ctx, &pb.CreateBookRequest{Id: "book-1", Title: "Duna", Quantity: 2}); err != nil {
+		t.Fatalf("CreateBook: %v", err)
+	}
+	if _, err := s.CreateBook(ctx, &pb.CreateBookRequest{Id: "book-2", Title: "Neuromancer", Quantity: 1}); err != nil {
+		t.Fatalf("CreateBook: %v", err)
+	}
+
+	stream := &fakeListBooksServer{}
+	if err := s.ListBooks(&pb.ListBooksRequest{}, stream); err != nil {
+		t.Fatalf("ListBooks: %v", err)
+	}
+
+	if len(stream.books) != 2 {
+		t.Fatalf("got %d books streamed, want 2", len(stream.books))
+	}
+}
+
+func TestServerDeleteBook(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	if _, err := s.CreateBook(ctx, &pb.CreateBookRequest{Id: "book-1", Title: "Duna", Quantity: 2}); err != nil {
+		t.Fatalf("CreateBook: %v", err)
+	}
+
+	if _, err := s.DeleteBook(ctx, &pb.DeleteBookRequest{Id: "book-1"}); err != nil {
+		t.Fatalf("DeleteBook: %v", err)
+	}
+
+	if _, err := s.GetBook(ctx, &pb.GetBookRequest{Id: "book-1"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("got code %v, want NotFound after delete", status.Code(err))
+	}
+}
+
+func TestServerDeleteBookNotFound(t *testing.T) {
+	s := newTestServer(t)
+
+	_, err := s.DeleteBook(context.Background(), &pb.DeleteBookRequest{Id: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("got code %v, want NotFound", status.Code(err))
+	}
+}
+
+// TestServerDeleteBookSQLiteWithAuthorAndPublisher exercita DeleteBook contra
+// o backend sqlite de verdade, com um livro que tem autor e editora: era o
+// caso realista mascarado pelos testes rodando só sobre memoryStore (a
+// FOREIGN KEY em author_books dá erro ao deletar o livro).
+func TestServerDeleteBookSQLiteWithAuthorAndPublisher(t *testing.T) {
+	s := newTestSQLiteServer(t)
+	ctx := context.Background()
+
+	if _, err := s.st.CreateAuthor(store.Author{ID: "author-1", Name: "Frank Herbert"}); err != nil {
+		t.Fatalf("CreateAuthor: %v", err)
+	}
+	if _, err := s.st.CreatePublisher(store.Publisher{ID: "pub-1", Name: "Chilton Books"}); err != nil {
+		t.Fatalf("CreatePublisher: %v", err)
+	}
+	if _, err := s.CreateBook(ctx, &pb.CreateBookRequest{
+		Id:          "book-1",
+		Title:       "Duna",
+		Quantity:    2,
+		PublisherId: "pub-1",
+		AuthorIds:   []string{"author-1"},
+	}); err != nil {
+		t.Fatalf("CreateBook: %v", err)
+	}
+
+	if _, err := s.DeleteBook(ctx, &pb.DeleteBookRequest{Id: "book-1"}); err != nil {
+		t.Fatalf("DeleteBook: %v", err)
+	}
+
+	if _, err := s.GetBook(ctx, &pb.GetBookRequest{Id: "book-1"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("got code %v, want NotFound after delete", status.Code(err))
+	}
+}
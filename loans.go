@@ -0,0 +1,43 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxActiveLoansPerUser é o limite de empréstimos simultâneos em aberto por
+// usuário e loanDuration é o prazo padrão de devolução a partir do checkout;
+// ambos configuráveis via config.MaxActiveLoansPerUser/LoanDurationDays e
+// aplicados por initLoanPolicy.
+var (
+	maxActiveLoansPerUser = 4
+	loanDuration          = 14 * 24 * time.Hour
+)
+
+// initLoanPolicy aplica os limites de empréstimo resolvidos em cfg.
+func initLoanPolicy(cfg config) {
+	maxActiveLoansPerUser = cfg.MaxActiveLoansPerUser
+	loanDuration = time.Duration(cfg.LoanDurationDays) * 24 * time.Hour
+}
+
+// função para retornar todos os empréstimos.
+func getLoans(c *gin.Context) {
+	loans, err := st.GetAllLoans()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao buscar empréstimos"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, loans)
+}
+
+// função para retornar os empréstimos em atraso.
+func getOverdueLoans(c *gin.Context) {
+	loans, err := st.GetOverdueLoans(time.Now().UTC())
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao buscar empréstimos em atraso"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, loans)
+}
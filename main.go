@@ -1,230 +1,150 @@
 package main
 
 import (
-	"database/sql"
-	"errors" // pacote para lidar com erros.
+	"context"
+	"errors"
 	"fmt"
-	"net/http" // pacote para interagir com funcionalidades HTTP.
-
-	"github.com/gin-gonic/gin"      // framework Gin para criar APIs.
-	_ "github.com/mattn/go-sqlite3" // importa o pacote SQLite para Go
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin" // framework Gin para criar APIs.
+	"google.golang.org/grpc"
+
+	"github.com/GuadalupePradoSR/Book-Library-API/grpcserver"
+	"github.com/GuadalupePradoSR/Book-Library-API/pb"
+	"github.com/GuadalupePradoSR/Book-Library-API/query"
+	"github.com/GuadalupePradoSR/Book-Library-API/store"
 )
 
-var db *sql.DB
-
-// estrutura do modelo de um livro.
-type book struct {
-	ID       string `json:"id"`       // ID no JSON.
-	Title    string `json:"title"`    // Título no JSON.
-	Author   string `json:"author"`   // Autor no JSON.
-	Quantity int    `json:"quantity"` // Quantidade no JSON.
-}
-
-// função para inicializar o banco de dados SQLite.
-func initDB() {
-	var err error
-	// cria ou abre o banco de dados SQLite
-	db, err = sql.Open("sqlite3", "./books.db")
-	if err != nil {
-		fmt.Println("Erro ao abrir o banco de dados:", err)
-		return
-	}
-
-	// cria a tabela de livros se não existir
-	createTableSQL := `
-	CREATE TABLE IF NOT EXISTS books (
-		id TEXT PRIMARY KEY,
-		title TEXT,
-		author TEXT,
-		quantity INTEGER
-	);`
-	_, err = db.Exec(createTableSQL)
-	if err != nil {
-		fmt.Println("Erro ao criar a tabela:", err)
-		return
-	}
-}
+var st store.Store
 
-// função para adicionar um novo livro à base de dados.
-func createBook(c *gin.Context) {
-	var newBook book
-	if err := c.BindJSON(&newBook); err != nil {
-		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
-		return
-	}
+// log é o logger de base da aplicação (sem request_id); handlers devem
+// preferir loggerFromContext(c, log) para incluir o request_id da requisição.
+var log *slog.Logger
 
-	// Verifica se o ID do livro já existe
-	var existingBook book
-	err := db.QueryRow("SELECT id FROM books WHERE id = ?", newBook.ID).Scan(&existingBook.ID)
-	if err != nil && err != sql.ErrNoRows {
-		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao verificar ID do livro: %v", err)})
-		return
-	}
-	if existingBook.ID != "" {
-		c.IndentedJSON(http.StatusConflict, gin.H{"message": "ID do livro já existe"})
-		return
-	}
+// gbClient é o cliente usado por /books/lookup para consultar metadados na
+// Google Books API.
+var gbClient query.BooksLookupper = query.NewGoogleBooksClient()
 
-	// Insere o livro no banco de dados
-	_, err = db.Exec("INSERT INTO books(id, title, author, quantity) VALUES (?, ?, ?, ?)",
-		newBook.ID, newBook.Title, newBook.Author, newBook.Quantity)
+// initDB inicializa a Store configurada em cfg.
+func initDB(cfg config) error {
+	s, err := store.New(cfg.StoreProvider, cfg.StoreDSN)
 	if err != nil {
-		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao adicionar livro: %v", err)})
-		return
+		return fmt.Errorf("erro ao inicializar store %q: %w", cfg.StoreProvider, err)
 	}
-
-	c.IndentedJSON(http.StatusCreated, newBook)
+	st = s
+	return nil
 }
 
-// função para retornar todos os livros.
-func getBooks(c *gin.Context) {
-	rows, err := db.Query("SELECT id, title, author, quantity FROM books")
-	if err != nil {
-		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao buscar livros"})
-		return
-	}
-	defer rows.Close()
-
-	var books []book
-	for rows.Next() {
-		var b book
-		if err := rows.Scan(&b.ID, &b.Title, &b.Author, &b.Quantity); err != nil {
-			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao ler os dados dos livros"})
-			return
-		}
-		books = append(books, b)
-	}
+// newRouter monta o router Gin com o logging/request-id middleware e todas
+// as rotas da API.
+func newRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(gin.Recovery())
+	router.Use(requestIDMiddleware(log))
+	router.Use(accessLogMiddleware(log))
 
-	c.IndentedJSON(http.StatusOK, books)
+	// livros
+	router.POST("/books", createBook)
+	router.GET("/books", getBooks)
+	router.GET("/books/:id", getBookByIdHandler)
+	router.PATCH("/books/checkout", checkoutBook)
+	router.PATCH("/books/return", returnBook)
+	router.GET("/books/lookup", lookupBook)
+
+	// autores
+	router.POST("/authors", createAuthor)
+	router.GET("/authors", getAuthors)
+	router.GET("/authors/:id", getAuthor)
+	router.PUT("/authors/:id", updateAuthor)
+	router.DELETE("/authors/:id", deleteAuthor)
+
+	// editoras
+	router.POST("/publishers", createPublisher)
+	router.GET("/publishers", getPublishers)
+	router.GET("/publishers/:id", getPublisher)
+	router.PUT("/publishers/:id", updatePublisher)
+	router.DELETE("/publishers/:id", deletePublisher)
+
+	// usuários
+	router.POST("/users", createUser)
+	router.GET("/users", getUsers)
+	router.GET("/users/:id", getUser)
+	router.PUT("/users/:id", updateUser)
+	router.DELETE("/users/:id", deleteUser)
+	router.GET("/users/:id/loans", getUserLoans)
+
+	// empréstimos
+	router.GET("/loans", getLoans)
+	router.GET("/loans/overdue", getOverdueLoans)
+
+	return router
 }
 
-// função para buscar um livro pelo ID.
-func getBookById(id string) (*book, error) {
-	fmt.Printf("Buscando livro com ID: %s\n", id)
-	row := db.QueryRow("SELECT id, title, author, quantity FROM books WHERE id = ?", id)
-
-	var b book
-	if err := row.Scan(&b.ID, &b.Title, &b.Author, &b.Quantity); err != nil {
-		if err == sql.ErrNoRows {
-			fmt.Println("Livro não encontrado")
-			return nil, errors.New("livro não encontrado")
-		}
-		fmt.Printf("Erro ao buscar livro: %v\n", err)
-		return nil, err
-	}
-
-	fmt.Printf("Livro encontrado: %+v\n", b)
-	return &b, nil
+// newGRPCServer cria o servidor gRPC (mesma Store da API REST).
+func newGRPCServer() *grpc.Server {
+	grpcServer := grpc.NewServer()
+	pb.RegisterBookLibraryServer(grpcServer, grpcserver.New(st, maxActiveLoansPerUser, loanDuration))
+	return grpcServer
 }
 
-// função para checkout de um livro.
-func checkoutBook(c *gin.Context) {
-	var request struct {
-		ID string `json:"id"`
-	}
-	if err := c.BindJSON(&request); err != nil {
-		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
-		return
-	}
-
-	fmt.Printf("Requisição de checkout para o livro com ID: %s\n", request.ID)
-
-	if request.ID == "" {
-		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "ID é necessário"})
-		return
-	}
-
-	book, err := getBookById(request.ID)
-	if err != nil {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Livro não encontrado"})
-		return
-	}
-
-	if book.Quantity <= 0 {
-		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Livro não disponível"})
-		return
-	}
-
-	// atualiza a quantidade do livro
-	_, err = db.Exec("UPDATE books SET quantity = quantity - 1 WHERE id = ?", request.ID)
+// inicializa a Store e os servidores HTTP/gRPC, e os desliga de forma
+// controlada ao receber SIGINT/SIGTERM: param de aceitar requisições novas,
+// drenam as em andamento e só então fecham a Store.
+func main() {
+	cfg, err := loadConfig(os.Args[1:])
 	if err != nil {
-		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao realizar checkout"})
-		return
-	}
-
-	c.IndentedJSON(http.StatusOK, book)
-}
-
-// função para retorno de um livro.
-func returnBook(c *gin.Context) {
-	var request struct {
-		ID string `json:"id"`
+		fmt.Println(err)
+		os.Exit(1)
 	}
-	if err := c.BindJSON(&request); err != nil {
-		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
-		return
-	}
-
-	fmt.Printf("Requisição de retorno para o livro com ID: %s\n", request.ID)
+	log = newLogger(cfg.LogLevel)
+	initLoanPolicy(cfg)
 
-	if request.ID == "" {
-		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "ID é necessário"})
-		return
+	if err := initDB(cfg); err != nil {
+		log.Error("erro ao inicializar store", "error", err)
+		os.Exit(1)
 	}
+	defer func() {
+		if err := st.Close(); err != nil {
+			log.Error("erro ao fechar store", "error", err)
+		}
+	}()
 
-	book, err := getBookById(request.ID)
-	if err != nil {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Livro não encontrado"})
-		return
-	}
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-	// atualiza a quantidade do livro
-	_, err = db.Exec("UPDATE books SET quantity = quantity + 1 WHERE id = ?", request.ID)
+	lis, err := net.Listen("tcp", cfg.GRPCAddr)
 	if err != nil {
-		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao retornar livro"})
-		return
+		log.Error("erro ao escutar endereço gRPC", "addr", cfg.GRPCAddr, "error", err)
+		os.Exit(1)
 	}
-
-	c.IndentedJSON(http.StatusOK, book)
-}
-
-// inicializa o servidor e registra as rotas.
-func main() {
-	initDB()
-	defer db.Close()
-	router := gin.Default() // cria um router padrão do Gin.
-
-	// criar um livro
-	router.POST("/books", createBook)
-
-	// listar os livros
-	router.GET("/books", getBooks)
-
-	// buscar um livro pelo ID
-	router.GET("/books/:id", func(c *gin.Context) {
-		id := c.Param("id")
-		book, err := getBookById(id)
-		if err != nil {
-			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Livro não encontrado"})
-			return
+	grpcServer := newGRPCServer()
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Error("erro no servidor gRPC", "error", err)
 		}
-		c.IndentedJSON(http.StatusOK, book)
-	})
+	}()
 
-	// realizar o checkout de um livro
-	router.PATCH("/books/checkout", checkoutBook)
-
-	// realizar o retorno de um livro
-	router.PATCH("/books/return", returnBook)
-
-	router.Run("localhost:8080")
-
-	// para executar o servidor, execute o comando no terminal:
-
-	// $env:PATH="C:\TDM-GCC-64\bin;$env:PATH"
+	httpServer := &http.Server{Addr: cfg.Addr, Handler: newRouter()}
+	go func() {
+		log.Info("servidor HTTP iniciado", "addr", cfg.Addr)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("erro no servidor HTTP", "error", err)
+		}
+	}()
 
-	// $env:CGO_ENABLED=1
-	// go build -o main.exe main.go
+	<-ctx.Done()
+	log.Info("sinal de desligamento recebido, drenando conexões")
 
-	// .\main.exe
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Error("erro ao desligar servidor HTTP", "error", err)
+	}
+	grpcServer.GracefulStop()
 }
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// loggerContextKey é a chave usada para guardar o logger por requisição em
+// gin.Context, já anotado com o request_id.
+const loggerContextKey = "logger"
+
+// requestIDMiddleware garante um X-Request-Id (gerando um se o cliente não
+// enviar), o ecoa na resposta e disponibiliza um *slog.Logger anotado com ele
+// via loggerFromContext para o resto da cadeia de handlers.
+func requestIDMiddleware(base *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader("X-Request-Id")
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		c.Header("X-Request-Id", requestID)
+		c.Set(loggerContextKey, base.With("request_id", requestID))
+		c.Next()
+	}
+}
+
+// loggerFromContext devolve o logger por requisição guardado por
+// requestIDMiddleware, ou base caso a middleware não tenha rodado (ex.: testes).
+func loggerFromContext(c *gin.Context, base *slog.Logger) *slog.Logger {
+	if l, ok := c.Get(loggerContextKey); ok {
+		if logger, ok := l.(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return base
+}
+
+// accessLogMiddleware registra cada requisição concluída em JSON (método,
+// caminho, status, duração e request_id), no formato esperado por coletores
+// de log em Docker/Kubernetes.
+func accessLogMiddleware(base *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		loggerFromContext(c, base).Info("requisição concluída",
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", c.Writer.Status(),
+			"duration_ms", time.Since(start).Milliseconds(),
+		)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/GuadalupePradoSR/Book-Library-API/store"
+)
+
+// função para cadastrar uma nova editora.
+func createPublisher(c *gin.Context) {
+	var newPublisher store.Publisher
+	if err := c.BindJSON(&newPublisher); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+
+	created, err := st.CreatePublisher(newPublisher)
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "ID da editora já existe"})
+			return
+		}
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao adicionar editora: %v", err)})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, created)
+}
+
+// função para retornar todas as editoras.
+func getPublishers(c *gin.Context) {
+	publishers, err := st.GetAllPublishers()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao buscar editoras"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, publishers)
+}
+
+// função para buscar uma editora pelo ID.
+func getPublisher(c *gin.Context) {
+	p, err := st.GetPublisher(c.Param("id"))
+	if err != nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Editora não encontrada"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, p)
+}
+
+// função para atualizar os dados de uma editora existente.
+func updatePublisher(c *gin.Context) {
+	var p store.Publisher
+	if err := c.BindJSON(&p); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+	p.ID = c.Param("id")
+
+	if err := st.UpdatePublisher(p); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Editora não encontrada"})
+			return
+		}
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao atualizar editora: %v", err)})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, p)
+}
+
+// função para remover uma editora.
+func deletePublisher(c *gin.Context) {
+	if err := st.DeletePublisher(c.Param("id")); err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Editora não encontrada"})
+		case errors.Is(err, store.ErrReferenced):
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "Editora ainda referenciada por um livro"})
+		default:
+			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao remover editora: %v", err)})
+		}
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,107 @@
+// Package query isola o cliente HTTP usado para consultar metadados de
+// livros em serviços externos, mantendo essa dependência de rede fora dos
+// handlers e da Store.
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrNotFound é retornado quando a busca não encontra nenhum volume para o
+// ISBN informado.
+var ErrNotFound = errors.New("nenhum livro encontrado para o ISBN informado")
+
+// BookInfo é o metadado de um livro obtido de um provedor externo, já
+// normalizado para o formato usado pela API local.
+type BookInfo struct {
+	ISBN        string
+	Title       string
+	Authors     []string
+	Publisher   string
+	Pages       int
+	CoverURL    string
+	Description string
+}
+
+// BooksLookupper busca metadados de um livro a partir do ISBN. É uma
+// interface para permitir stubs em testes sem bater em rede.
+type BooksLookupper interface {
+	LookupISBN(ctx context.Context, isbn string) (BookInfo, error)
+}
+
+// GoogleBooksClient implementa BooksLookupper contra a Google Books Volumes
+// API (https://developers.google.com/books/docs/v1/using#WorkingVolumes).
+type GoogleBooksClient struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewGoogleBooksClient cria um GoogleBooksClient com um timeout padrão de
+// 5 segundos por requisição.
+func NewGoogleBooksClient() *GoogleBooksClient {
+	return &GoogleBooksClient{
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		baseURL:    "https://www.googleapis.com/books/v1/volumes",
+	}
+}
+
+type volumesResponse struct {
+	Items []struct {
+		VolumeInfo struct {
+			Title       string   `json:"title"`
+			Authors     []string `json:"authors"`
+			Publisher   string   `json:"publisher"`
+			PageCount   int      `json:"pageCount"`
+			Description string   `json:"description"`
+			ImageLinks  struct {
+				Thumbnail string `json:"thumbnail"`
+			} `json:"imageLinks"`
+		} `json:"volumeInfo"`
+	} `json:"items"`
+}
+
+// LookupISBN consulta a Google Books Volumes API pelo ISBN e retorna o
+// primeiro volume encontrado, ou ErrNotFound se a busca não retornar itens.
+func (c *GoogleBooksClient) LookupISBN(ctx context.Context, isbn string) (BookInfo, error) {
+	reqURL := fmt.Sprintf("%s?q=%s", c.baseURL, url.QueryEscape("isbn:"+isbn))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return BookInfo{}, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return BookInfo{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return BookInfo{}, fmt.Errorf("query: Google Books retornou status %d", resp.StatusCode)
+	}
+
+	var parsed volumesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return BookInfo{}, err
+	}
+	if len(parsed.Items) == 0 {
+		return BookInfo{}, ErrNotFound
+	}
+
+	v := parsed.Items[0].VolumeInfo
+	return BookInfo{
+		ISBN:        isbn,
+		Title:       v.Title,
+		Authors:     v.Authors,
+		Publisher:   v.Publisher,
+		Pages:       v.PageCount,
+		CoverURL:    v.ImageLinks.Thumbnail,
+		Description: v.Description,
+	}, nil
+}
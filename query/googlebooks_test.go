@@ -0,0 +1,66 @@
+package query
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *GoogleBooksClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := NewGoogleBooksClient()
+	c.baseURL = server.URL
+	return c
+}
+
+func TestLookupISBNFound(t *testing.T) {
+	const body = `{"items":[{"volumeInfo":{
+		"title":"Duna",
+		"authors":["Frank Herbert"],
+		"publisher":"Aleph",
+		"pageCount":688,
+		"description":"Um romance de ficção científica.",
+		"imageLinks":{"thumbnail":"https://example.com/duna.jpg"}
+	}}]}`
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	})
+
+	info, err := c.LookupISBN(context.Background(), "9788576572128")
+	if err != nil {
+		t.Fatalf("LookupISBN: %v", err)
+	}
+	if info.Title != "Duna" || info.Publisher != "Aleph" || info.Pages != 688 {
+		t.Fatalf("got %+v, want Title=Duna Publisher=Aleph Pages=688", info)
+	}
+	if len(info.Authors) != 1 || info.Authors[0] != "Frank Herbert" {
+		t.Fatalf("got Authors %+v, want [Frank Herbert]", info.Authors)
+	}
+}
+
+func TestLookupISBNNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items":[]}`))
+	})
+
+	if _, err := c.LookupISBN(context.Background(), "0000000000"); err != ErrNotFound {
+		t.Fatalf("got err %v, want ErrNotFound", err)
+	}
+}
+
+func TestLookupISBNUpstreamError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := c.LookupISBN(context.Background(), "9788576572128"); err == nil {
+		t.Fatal("got nil error, want an error for a non-200 upstream response")
+	}
+}
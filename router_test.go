@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestNewRouterRegistersAllDocumentedRoutes garante que todo método+caminho
+// documentado em newRouter() de fato está registrado no router do Gin. Isso
+// já aconteceu duas vezes (5a92a3d, 0e2e683): um handler existia na Store mas
+// não tinha rota HTTP, então a chamada caía no 404 padrão do Gin sem que
+// nenhum teste percebesse. Um novo recurso que esqueça de registrar uma rota
+// deve quebrar este teste em vez de chegar em produção.
+func TestNewRouterRegistersAllDocumentedRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log = newLogger("error")
+
+	router := newRouter()
+
+	registered := make(map[string]bool)
+	for _, r := range router.Routes() {
+		registered[r.Method+" "+r.Path] = true
+	}
+
+	want := []string{
+		"POST /books",
+		"GET /books",
+		"GET /books/:id",
+		"PATCH /books/checkout",
+		"PATCH /books/return",
+		"GET /books/lookup",
+
+		"POST /authors",
+		"GET /authors",
+		"GET /authors/:id",
+		"PUT /authors/:id",
+		"DELETE /authors/:id",
+
+		"POST /publishers",
+		"GET /publishers",
+		"GET /publishers/:id",
+		"PUT /publishers/:id",
+		"DELETE /publishers/:id",
+
+		"POST /users",
+		"GET /users",
+		"GET /users/:id",
+		"PUT /users/:id",
+		"DELETE /users/:id",
+		"GET /users/:id/loans",
+
+		"GET /loans",
+		"GET /loans/overdue",
+	}
+
+	for _, route := range want {
+		if !registered[route] {
+			t.Errorf("route %q not registered in newRouter()", route)
+		}
+	}
+}
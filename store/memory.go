@@ -0,0 +1,417 @@
+package store
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func init() {
+	Register("memory", newMemoryStore)
+}
+
+// memoryStore implementa Store em memória, útil para testes e para rodar a
+// API sem CGO/SQLite. dsn é ignorado.
+type memoryStore struct {
+	mu         sync.Mutex
+	books      map[string]Book
+	authors    map[string]Author
+	publishers map[string]Publisher
+	users      map[string]User
+	loans      map[string]Loan
+}
+
+func newMemoryStore(dsn string) (Store, error) {
+	return &memoryStore{
+		books:      make(map[string]Book),
+		authors:    make(map[string]Author),
+		publishers: make(map[string]Publisher),
+		users:      make(map[string]User),
+		loans:      make(map[string]Loan),
+	}, nil
+}
+
+// Close não libera nada: memoryStore não tem recursos externos.
+func (s *memoryStore) Close() error {
+	return nil
+}
+
+// hydrate retorna uma cópia de b com Authors e Publisher preenchidos.
+func (s *memoryStore) hydrate(b Book) Book {
+	if b.PublisherID != "" {
+		if p, ok := s.publishers[b.PublisherID]; ok {
+			b.Publisher = &p
+		}
+	}
+
+	b.Authors = nil
+	for _, authorID := range b.AuthorIDs {
+		if a, ok := s.authors[authorID]; ok {
+			b.Authors = append(b.Authors, a)
+		}
+	}
+	return b
+}
+
+// validateBookRefs retorna ErrInvalidReference se b referenciar um
+// PublisherID ou AuthorID que não existe, espelhando o que a sqliteStore
+// ganha de graça de PRAGMA foreign_keys. Deve ser chamado com s.mu já preso.
+func (s *memoryStore) validateBookRefs(b Book) error {
+	if b.PublisherID != "" {
+		if _, ok := s.publishers[b.PublisherID]; !ok {
+			return ErrInvalidReference
+		}
+	}
+	for _, authorID := range b.AuthorIDs {
+		if _, ok := s.authors[authorID]; !ok {
+			return ErrInvalidReference
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) Create(b Book) (Book, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.books[b.ID]; ok {
+		return Book{}, ErrConflict
+	}
+	if err := s.validateBookRefs(b); err != nil {
+		return Book{}, err
+	}
+	s.books[b.ID] = b
+	return s.hydrate(b), nil
+}
+
+func (s *memoryStore) Update(b Book) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.books[b.ID]; !ok {
+		return ErrNotFound
+	}
+	if err := s.validateBookRefs(b); err != nil {
+		return err
+	}
+	s.books[b.ID] = b
+	return nil
+}
+
+func (s *memoryStore) Get(id string) (Book, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.books[id]
+	if !ok {
+		return Book{}, ErrNotFound
+	}
+	return s.hydrate(b), nil
+}
+
+func (s *memoryStore) GetAll() ([]Book, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	books := make([]Book, 0, len(s.books))
+	for _, b := range s.books {
+		books = append(books, s.hydrate(b))
+	}
+	return books, nil
+}
+
+func (s *memoryStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.books[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.books, id)
+	return nil
+}
+
+func (s *memoryStore) CreateAuthor(a Author) (Author, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.authors[a.ID]; ok {
+		return Author{}, ErrConflict
+	}
+	s.authors[a.ID] = a
+	return a, nil
+}
+
+func (s *memoryStore) GetAuthor(id string) (Author, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.authors[id]
+	if !ok {
+		return Author{}, ErrNotFound
+	}
+	return a, nil
+}
+
+func (s *memoryStore) GetAllAuthors() ([]Author, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	authors := make([]Author, 0, len(s.authors))
+	for _, a := range s.authors {
+		authors = append(authors, a)
+	}
+	return authors, nil
+}
+
+func (s *memoryStore) UpdateAuthor(a Author) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.authors[a.ID]; !ok {
+		return ErrNotFound
+	}
+	s.authors[a.ID] = a
+	return nil
+}
+
+func (s *memoryStore) DeleteAuthor(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.authors[id]; !ok {
+		return ErrNotFound
+	}
+	for _, b := range s.books {
+		for _, authorID := range b.AuthorIDs {
+			if authorID == id {
+				return ErrReferenced
+			}
+		}
+	}
+	delete(s.authors, id)
+	return nil
+}
+
+func (s *memoryStore) CreatePublisher(p Publisher) (Publisher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.publishers[p.ID]; ok {
+		return Publisher{}, ErrConflict
+	}
+	s.publishers[p.ID] = p
+	return p, nil
+}
+
+func (s *memoryStore) GetPublisher(id string) (Publisher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.publishers[id]
+	if !ok {
+		return Publisher{}, ErrNotFound
+	}
+	return p, nil
+}
+
+func (s *memoryStore) GetAllPublishers() ([]Publisher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	publishers := make([]Publisher, 0, len(s.publishers))
+	for _, p := range s.publishers {
+		publishers = append(publishers, p)
+	}
+	return publishers, nil
+}
+
+func (s *memoryStore) UpdatePublisher(p Publisher) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.publishers[p.ID]; !ok {
+		return ErrNotFound
+	}
+	s.publishers[p.ID] = p
+	return nil
+}
+
+func (s *memoryStore) DeletePublisher(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.publishers[id]; !ok {
+		return ErrNotFound
+	}
+	for _, b := range s.books {
+		if b.PublisherID == id {
+			return ErrReferenced
+		}
+	}
+	delete(s.publishers, id)
+	return nil
+}
+
+func (s *memoryStore) CreateUser(u User) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[u.ID]; ok {
+		return User{}, ErrConflict
+	}
+	s.users[u.ID] = u
+	return u, nil
+}
+
+func (s *memoryStore) GetUser(id string) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[id]
+	if !ok {
+		return User{}, ErrNotFound
+	}
+	return u, nil
+}
+
+func (s *memoryStore) GetAllUsers() ([]User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := make([]User, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (s *memoryStore) UpdateUser(u User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[u.ID]; !ok {
+		return ErrNotFound
+	}
+	s.users[u.ID] = u
+	return nil
+}
+
+func (s *memoryStore) DeleteUser(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.users, id)
+	return nil
+}
+
+func (s *memoryStore) Checkout(userID, bookID string, maxActiveLoans int, due time.Time) (Loan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activeLoans := 0
+	for _, l := range s.loans {
+		if l.UserID == userID && l.ReturnedAt == nil {
+			activeLoans++
+		}
+	}
+	if activeLoans >= maxActiveLoans {
+		return Loan{}, ErrLoanCapExceeded
+	}
+
+	b, ok := s.books[bookID]
+	if !ok {
+		return Loan{}, ErrNotFound
+	}
+	if b.Quantity <= 0 {
+		return Loan{}, ErrNoStock
+	}
+	b.Quantity--
+	s.books[bookID] = b
+
+	loan := Loan{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		BookID:     bookID,
+		CheckoutAt: time.Now().UTC(),
+		DueAt:      due,
+	}
+	s.loans[loan.ID] = loan
+	return loan, nil
+}
+
+func (s *memoryStore) Return(loanID string) (Loan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	loan, ok := s.loans[loanID]
+	if !ok {
+		return Loan{}, ErrNotFound
+	}
+	if loan.ReturnedAt != nil {
+		return Loan{}, ErrAlreadyReturned
+	}
+
+	returnedAt := time.Now().UTC()
+	loan.ReturnedAt = &returnedAt
+	s.loans[loanID] = loan
+
+	if b, ok := s.books[loan.BookID]; ok {
+		b.Quantity++
+		s.books[loan.BookID] = b
+	}
+
+	return loan, nil
+}
+
+func (s *memoryStore) GetLoan(id string) (Loan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.loans[id]
+	if !ok {
+		return Loan{}, ErrNotFound
+	}
+	return l, nil
+}
+
+func (s *memoryStore) GetAllLoans() ([]Loan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	loans := make([]Loan, 0, len(s.loans))
+	for _, l := range s.loans {
+		loans = append(loans, l)
+	}
+	return loans, nil
+}
+
+func (s *memoryStore) GetOverdueLoans(now time.Time) ([]Loan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var overdue []Loan
+	for _, l := range s.loans {
+		if l.ReturnedAt == nil && l.DueAt.Before(now) {
+			overdue = append(overdue, l)
+		}
+	}
+	return overdue, nil
+}
+
+func (s *memoryStore) GetLoansByUser(userID string) ([]Loan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var loans []Loan
+	for _, l := range s.loans {
+		if l.UserID == userID {
+			loans = append(loans, l)
+		}
+	}
+	return loans, nil
+}
@@ -0,0 +1,167 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreCreateGetAll(t *testing.T) {
+	s, err := New("memory", "")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", Quantity: 2}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna"}); err != ErrConflict {
+		t.Fatalf("got err %v, want ErrConflict", err)
+	}
+
+	got, err := s.Get("book-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Title != "Duna" || got.Quantity != 2 {
+		t.Fatalf("got %+v, want Title=Duna Quantity=2", got)
+	}
+
+	all, err := s.GetAll()
+	if err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if len(all) != 1 {
+		t.Fatalf("got %d books, want 1", len(all))
+	}
+
+	if _, err := s.Get("missing"); err != ErrNotFound {
+		t.Fatalf("got err %v, want ErrNotFound", err)
+	}
+}
+
+func TestMemoryStoreHydratesAuthorsAndPublisher(t *testing.T) {
+	s, _ := New("memory", "")
+
+	if _, err := s.CreateAuthor(Author{ID: "author-1", Name: "Frank Herbert"}); err != nil {
+		t.Fatalf("CreateAuthor: %v", err)
+	}
+	if _, err := s.CreatePublisher(Publisher{ID: "pub-1", Name: "Chilton Books"}); err != nil {
+		t.Fatalf("CreatePublisher: %v", err)
+	}
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", PublisherID: "pub-1", AuthorIDs: []string{"author-1"}}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	got, err := s.Get("book-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Publisher == nil || got.Publisher.Name != "Chilton Books" {
+		t.Fatalf("got Publisher %+v, want Chilton Books hydrated", got.Publisher)
+	}
+	if len(got.Authors) != 1 || got.Authors[0].Name != "Frank Herbert" {
+		t.Fatalf("got Authors %+v, want a single Frank Herbert", got.Authors)
+	}
+}
+
+func TestMemoryStoreCreateRejectsDanglingReferences(t *testing.T) {
+	s, _ := New("memory", "")
+
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", AuthorIDs: []string{"does-not-exist"}}); err != ErrInvalidReference {
+		t.Fatalf("got err %v, want ErrInvalidReference for dangling AuthorIDs", err)
+	}
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", PublisherID: "does-not-exist"}); err != ErrInvalidReference {
+		t.Fatalf("got err %v, want ErrInvalidReference for dangling PublisherID", err)
+	}
+}
+
+func TestMemoryStoreDeleteAuthorAndPublisherRejectWhileReferenced(t *testing.T) {
+	s, _ := New("memory", "")
+
+	if _, err := s.CreateAuthor(Author{ID: "author-1", Name: "Frank Herbert"}); err != nil {
+		t.Fatalf("CreateAuthor: %v", err)
+	}
+	if _, err := s.CreatePublisher(Publisher{ID: "pub-1", Name: "Chilton Books"}); err != nil {
+		t.Fatalf("CreatePublisher: %v", err)
+	}
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", PublisherID: "pub-1", AuthorIDs: []string{"author-1"}}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if err := s.DeleteAuthor("author-1"); err != ErrReferenced {
+		t.Fatalf("got err %v, want ErrReferenced while book-1 still references author-1", err)
+	}
+	if err := s.DeletePublisher("pub-1"); err != ErrReferenced {
+		t.Fatalf("got err %v, want ErrReferenced while book-1 still references pub-1", err)
+	}
+
+	if err := s.Delete("book-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := s.DeleteAuthor("author-1"); err != nil {
+		t.Fatalf("DeleteAuthor after book removed: %v", err)
+	}
+	if err := s.DeletePublisher("pub-1"); err != nil {
+		t.Fatalf("DeletePublisher after book removed: %v", err)
+	}
+}
+
+func TestMemoryStoreCheckoutAndReturn(t *testing.T) {
+	s, _ := New("memory", "")
+	due := time.Now().UTC().Add(14 * 24 * time.Hour)
+
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", Quantity: 1}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := s.CreateUser(User{ID: "user-1", Name: "Ana"}); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	loan, err := s.Checkout("user-1", "book-1", 4, due)
+	if err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+
+	if _, err := s.Checkout("user-1", "book-1", 4, due); err != ErrNoStock {
+		t.Fatalf("got err %v, want ErrNoStock", err)
+	}
+
+	returned, err := s.Return(loan.ID)
+	if err != nil {
+		t.Fatalf("Return: %v", err)
+	}
+	if returned.ReturnedAt == nil {
+		t.Fatalf("got ReturnedAt = nil, want it set")
+	}
+
+	if _, err := s.Return(loan.ID); err != ErrAlreadyReturned {
+		t.Fatalf("got err %v, want ErrAlreadyReturned", err)
+	}
+
+	got, err := s.Get("book-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Quantity != 1 {
+		t.Fatalf("got Quantity %d after return, want 1", got.Quantity)
+	}
+}
+
+func TestMemoryStoreCheckoutRespectsLoanCap(t *testing.T) {
+	s, _ := New("memory", "")
+	due := time.Now().UTC().Add(14 * 24 * time.Hour)
+
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", Quantity: 10}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := s.CreateUser(User{ID: "user-1", Name: "Ana"}); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	if _, err := s.Checkout("user-1", "book-1", 1, due); err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	if _, err := s.Checkout("user-1", "book-1", 1, due); err != ErrLoanCapExceeded {
+		t.Fatalf("got err %v, want ErrLoanCapExceeded", err)
+	}
+}
@@ -0,0 +1,815 @@
+package store
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3" // driver SQLite para database/sql
+)
+
+func init() {
+	Register("sqlite", newSQLiteStore)
+}
+
+// sqliteStore implementa Store usando SQLite via database/sql.
+type sqliteStore struct {
+	db *sql.DB
+}
+
+// newSQLiteStore abre (ou cria) o arquivo SQLite em dsn e garante o schema.
+func newSQLiteStore(dsn string) (Store, error) {
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+	// SQLite só permite um escritor por vez; serializar as conexões do pool
+	// evita "database is locked" sob escritas concorrentes (ex.: múltiplos
+	// checkouts do mesmo livro) em vez de competir por um lock de arquivo.
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec("PRAGMA foreign_keys = ON; PRAGMA busy_timeout = 5000;"); err != nil {
+		return nil, err
+	}
+
+	const schemaSQL = `
+	CREATE TABLE IF NOT EXISTS publishers (
+		id TEXT PRIMARY KEY,
+		name TEXT
+	);
+	CREATE TABLE IF NOT EXISTS authors (
+		id TEXT PRIMARY KEY,
+		name TEXT
+	);
+	CREATE TABLE IF NOT EXISTS books (
+		id TEXT PRIMARY KEY,
+		title TEXT,
+		quantity INTEGER,
+		publisher_id TEXT REFERENCES publishers(id),
+		isbn TEXT,
+		pages INTEGER,
+		cover_url TEXT,
+		description TEXT
+	);
+	CREATE TABLE IF NOT EXISTS author_books (
+		author_id TEXT NOT NULL REFERENCES authors(id),
+		book_id TEXT NOT NULL REFERENCES books(id),
+		PRIMARY KEY (author_id, book_id)
+	);
+	CREATE TABLE IF NOT EXISTS users (
+		id TEXT PRIMARY KEY,
+		name TEXT
+	);
+	CREATE TABLE IF NOT EXISTS loans (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL REFERENCES users(id),
+		book_id TEXT NOT NULL REFERENCES books(id),
+		checkout_at DATETIME NOT NULL,
+		due_at DATETIME NOT NULL,
+		returned_at DATETIME
+	);`
+	if _, err := db.Exec(schemaSQL); err != nil {
+		return nil, err
+	}
+	if err := migrateLegacyBooks(db); err != nil {
+		return nil, err
+	}
+
+	return &sqliteStore{db: db}, nil
+}
+
+// migrateLegacyBooks atualiza um arquivo de banco criado antes do catálogo de
+// autores/editoras (chunk0-2): adiciona as colunas que o schema ganhou desde
+// então e, se a tabela ainda tiver a antiga coluna de texto livre `author`,
+// converte cada valor em um Author e liga o livro a ele via author_books.
+// Em um banco novo (sem a tabela `books` pré-existente) isso é um no-op.
+func migrateLegacyBooks(db *sql.DB) error {
+	rows, err := db.Query("PRAGMA table_info(books)")
+	if err != nil {
+		return err
+	}
+	cols := make(map[string]bool)
+	for rows.Next() {
+		var cid, notnull, pk int
+		var name, ctype string
+		var dflt sql.NullString
+		if err := rows.Scan(&cid, &name, &ctype, &notnull, &dflt, &pk); err != nil {
+			rows.Close()
+			return err
+		}
+		cols[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	newColumns := []struct{ name, ddlType string }{
+		{"publisher_id", "TEXT REFERENCES publishers(id)"},
+		{"isbn", "TEXT"},
+		{"pages", "INTEGER DEFAULT 0"},
+		{"cover_url", "TEXT"},
+		{"description", "TEXT"},
+	}
+	for _, col := range newColumns {
+		if cols[col.name] {
+			continue
+		}
+		if _, err := db.Exec("ALTER TABLE books ADD COLUMN " + col.name + " " + col.ddlType); err != nil {
+			return err
+		}
+	}
+
+	if !cols["author"] {
+		return nil
+	}
+
+	legacyRows, err := db.Query("SELECT id, author FROM books WHERE author IS NOT NULL AND author != ''")
+	if err != nil {
+		return err
+	}
+	type legacyAuthor struct{ bookID, name string }
+	var legacy []legacyAuthor
+	for legacyRows.Next() {
+		var la legacyAuthor
+		if err := legacyRows.Scan(&la.bookID, &la.name); err != nil {
+			legacyRows.Close()
+			return err
+		}
+		legacy = append(legacy, la)
+	}
+	if err := legacyRows.Err(); err != nil {
+		legacyRows.Close()
+		return err
+	}
+	legacyRows.Close()
+
+	for _, la := range legacy {
+		var authorID string
+		err := db.QueryRow("SELECT id FROM authors WHERE name = ?", la.name).Scan(&authorID)
+		switch {
+		case err == sql.ErrNoRows:
+			authorID = uuid.NewString()
+			if _, err := db.Exec("INSERT INTO authors(id, name) VALUES (?, ?)", authorID, la.name); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		}
+
+		if _, err := db.Exec(
+			"INSERT OR IGNORE INTO author_books(author_id, book_id) VALUES (?, ?)", authorID, la.bookID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withTx executa fn dentro de uma transação, fazendo commit se fn retornar
+// nil e rollback caso contrário (incluindo panics). Centraliza o padrão
+// BEGIN/COMMIT/ROLLBACK usado por toda escrita que precisa ser atômica, como
+// o decremento de quantity em Checkout e o incremento em Return.
+func withTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
+
+// validateBookRefs retorna ErrInvalidReference se b referenciar um
+// PublisherID ou AuthorID que não existe, traduzindo antecipadamente o que
+// seria uma violação de FOREIGN KEY em um erro sentinela igual ao que
+// memoryStore retorna para o mesmo caso.
+func validateBookRefs(tx *sql.Tx, b Book) error {
+	if b.PublisherID != "" {
+		var exists int
+		err := tx.QueryRow("SELECT 1 FROM publishers WHERE id = ?", b.PublisherID).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return ErrInvalidReference
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, authorID := range b.AuthorIDs {
+		var exists int
+		err := tx.QueryRow("SELECT 1 FROM authors WHERE id = ?", authorID).Scan(&exists)
+		if err == sql.ErrNoRows {
+			return ErrInvalidReference
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqliteStore) Create(b Book) (Book, error) {
+	var existingID string
+	err := s.db.QueryRow("SELECT id FROM books WHERE id = ?", b.ID).Scan(&existingID)
+	if err != nil && err != sql.ErrNoRows {
+		return Book{}, err
+	}
+	if existingID != "" {
+		return Book{}, ErrConflict
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return Book{}, err
+	}
+	defer tx.Rollback()
+
+	if err := validateBookRefs(tx, b); err != nil {
+		return Book{}, err
+	}
+
+	_, err = tx.Exec(`INSERT INTO books(id, title, quantity, publisher_id, isbn, pages, cover_url, description)
+		VALUES (?, ?, ?, NULLIF(?, ''), ?, ?, ?, ?)`,
+		b.ID, b.Title, b.Quantity, b.PublisherID, b.ISBN, b.Pages, b.CoverURL, b.Description)
+	if err != nil {
+		return Book{}, err
+	}
+
+	for _, authorID := range b.AuthorIDs {
+		if _, err := tx.Exec("INSERT INTO author_books(author_id, book_id) VALUES (?, ?)", authorID, b.ID); err != nil {
+			return Book{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Book{}, err
+	}
+
+	return s.Get(b.ID)
+}
+
+func (s *sqliteStore) Update(b Book) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := validateBookRefs(tx, b); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(`UPDATE books SET title = ?, quantity = ?, publisher_id = NULLIF(?, ''),
+		isbn = ?, pages = ?, cover_url = ?, description = ? WHERE id = ?`,
+		b.Title, b.Quantity, b.PublisherID, b.ISBN, b.Pages, b.CoverURL, b.Description, b.ID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	if _, err := tx.Exec("DELETE FROM author_books WHERE book_id = ?", b.ID); err != nil {
+		return err
+	}
+	for _, authorID := range b.AuthorIDs {
+		if _, err := tx.Exec("INSERT INTO author_books(author_id, book_id) VALUES (?, ?)", authorID, b.ID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+const bookColumns = `id, title, quantity, COALESCE(publisher_id, ''), COALESCE(isbn, ''),
+		pages, COALESCE(cover_url, ''), COALESCE(description, '')`
+
+func scanBook(row interface{ Scan(...any) error }, b *Book) error {
+	return row.Scan(&b.ID, &b.Title, &b.Quantity, &b.PublisherID, &b.ISBN, &b.Pages, &b.CoverURL, &b.Description)
+}
+
+func (s *sqliteStore) Get(id string) (Book, error) {
+	row := s.db.QueryRow("SELECT "+bookColumns+" FROM books WHERE id = ?", id)
+
+	var b Book
+	if err := scanBook(row, &b); err != nil {
+		if err == sql.ErrNoRows {
+			return Book{}, ErrNotFound
+		}
+		return Book{}, err
+	}
+
+	if err := s.hydrate(&b); err != nil {
+		return Book{}, err
+	}
+	return b, nil
+}
+
+func (s *sqliteStore) GetAll() ([]Book, error) {
+	rows, err := s.db.Query("SELECT " + bookColumns + " FROM books")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var books []Book
+	for rows.Next() {
+		var b Book
+		if err := scanBook(rows, &b); err != nil {
+			return nil, err
+		}
+		books = append(books, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range books {
+		if err := s.hydrate(&books[i]); err != nil {
+			return nil, err
+		}
+	}
+	return books, nil
+}
+
+// hydrate preenche Authors e Publisher de b a partir de PublisherID e da
+// tabela author_books.
+func (s *sqliteStore) hydrate(b *Book) error {
+	if b.PublisherID != "" {
+		publisher, err := s.GetPublisher(b.PublisherID)
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+		if err == nil {
+			b.Publisher = &publisher
+		}
+	}
+
+	rows, err := s.db.Query(`
+		SELECT authors.id, authors.name
+		FROM authors
+		JOIN author_books ON author_books.author_id = authors.id
+		WHERE author_books.book_id = ?`, b.ID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	b.AuthorIDs = nil
+	b.Authors = nil
+	for rows.Next() {
+		var a Author
+		if err := rows.Scan(&a.ID, &a.Name); err != nil {
+			return err
+		}
+		b.AuthorIDs = append(b.AuthorIDs, a.ID)
+		b.Authors = append(b.Authors, a)
+	}
+	return rows.Err()
+}
+
+// Delete remove o livro e suas linhas em author_books numa única transação,
+// já que essa tabela de junção é uma associação interna do próprio livro
+// (diferente de ser "referenciado" por outro registro).
+func (s *sqliteStore) Delete(id string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM author_books WHERE book_id = ?", id); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec("DELETE FROM books WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) CreateAuthor(a Author) (Author, error) {
+	var existingID string
+	err := s.db.QueryRow("SELECT id FROM authors WHERE id = ?", a.ID).Scan(&existingID)
+	if err != nil && err != sql.ErrNoRows {
+		return Author{}, err
+	}
+	if existingID != "" {
+		return Author{}, ErrConflict
+	}
+
+	if _, err := s.db.Exec("INSERT INTO authors(id, name) VALUES (?, ?)", a.ID, a.Name); err != nil {
+		return Author{}, err
+	}
+	return a, nil
+}
+
+func (s *sqliteStore) GetAuthor(id string) (Author, error) {
+	var a Author
+	err := s.db.QueryRow("SELECT id, name FROM authors WHERE id = ?", id).Scan(&a.ID, &a.Name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Author{}, ErrNotFound
+		}
+		return Author{}, err
+	}
+	return a, nil
+}
+
+func (s *sqliteStore) GetAllAuthors() ([]Author, error) {
+	rows, err := s.db.Query("SELECT id, name FROM authors")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []Author
+	for rows.Next() {
+		var a Author
+		if err := rows.Scan(&a.ID, &a.Name); err != nil {
+			return nil, err
+		}
+		authors = append(authors, a)
+	}
+	return authors, rows.Err()
+}
+
+func (s *sqliteStore) UpdateAuthor(a Author) error {
+	result, err := s.db.Exec("UPDATE authors SET name = ? WHERE id = ?", a.Name, a.ID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// DeleteAuthor recusa remover um autor ainda referenciado em author_books,
+// em vez de deixar a violação de FOREIGN KEY vazar como erro bruto do driver.
+func (s *sqliteStore) DeleteAuthor(id string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRow("SELECT 1 FROM author_books WHERE author_id = ?", id).Scan(&exists)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		return ErrReferenced
+	}
+
+	result, err := tx.Exec("DELETE FROM authors WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) CreatePublisher(p Publisher) (Publisher, error) {
+	var existingID string
+	err := s.db.QueryRow("SELECT id FROM publishers WHERE id = ?", p.ID).Scan(&existingID)
+	if err != nil && err != sql.ErrNoRows {
+		return Publisher{}, err
+	}
+	if existingID != "" {
+		return Publisher{}, ErrConflict
+	}
+
+	if _, err := s.db.Exec("INSERT INTO publishers(id, name) VALUES (?, ?)", p.ID, p.Name); err != nil {
+		return Publisher{}, err
+	}
+	return p, nil
+}
+
+func (s *sqliteStore) GetPublisher(id string) (Publisher, error) {
+	var p Publisher
+	err := s.db.QueryRow("SELECT id, name FROM publishers WHERE id = ?", id).Scan(&p.ID, &p.Name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Publisher{}, ErrNotFound
+		}
+		return Publisher{}, err
+	}
+	return p, nil
+}
+
+func (s *sqliteStore) GetAllPublishers() ([]Publisher, error) {
+	rows, err := s.db.Query("SELECT id, name FROM publishers")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var publishers []Publisher
+	for rows.Next() {
+		var p Publisher
+		if err := rows.Scan(&p.ID, &p.Name); err != nil {
+			return nil, err
+		}
+		publishers = append(publishers, p)
+	}
+	return publishers, rows.Err()
+}
+
+func (s *sqliteStore) UpdatePublisher(p Publisher) error {
+	result, err := s.db.Exec("UPDATE publishers SET name = ? WHERE id = ?", p.Name, p.ID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// DeletePublisher recusa remover uma editora ainda referenciada por algum
+// livro, em vez de deixar a violação de FOREIGN KEY vazar como erro bruto do driver.
+func (s *sqliteStore) DeletePublisher(id string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRow("SELECT 1 FROM books WHERE publisher_id = ?", id).Scan(&exists)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		return ErrReferenced
+	}
+
+	result, err := tx.Exec("DELETE FROM publishers WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) CreateUser(u User) (User, error) {
+	var existingID string
+	err := s.db.QueryRow("SELECT id FROM users WHERE id = ?", u.ID).Scan(&existingID)
+	if err != nil && err != sql.ErrNoRows {
+		return User{}, err
+	}
+	if existingID != "" {
+		return User{}, ErrConflict
+	}
+
+	if _, err := s.db.Exec("INSERT INTO users(id, name) VALUES (?, ?)", u.ID, u.Name); err != nil {
+		return User{}, err
+	}
+	return u, nil
+}
+
+func (s *sqliteStore) GetUser(id string) (User, error) {
+	var u User
+	err := s.db.QueryRow("SELECT id, name FROM users WHERE id = ?", id).Scan(&u.ID, &u.Name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return User{}, ErrNotFound
+		}
+		return User{}, err
+	}
+	return u, nil
+}
+
+func (s *sqliteStore) GetAllUsers() ([]User, error) {
+	rows, err := s.db.Query("SELECT id, name FROM users")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.ID, &u.Name); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+func (s *sqliteStore) UpdateUser(u User) error {
+	result, err := s.db.Exec("UPDATE users SET name = ? WHERE id = ?", u.Name, u.ID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqliteStore) DeleteUser(id string) error {
+	result, err := s.db.Exec("DELETE FROM users WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Checkout decrementa a quantidade disponível de bookID e insere o
+// empréstimo em uma única transação. SQLite não tem `SELECT ... FOR UPDATE`:
+// a mesma garantia é obtida fazendo o decremento condicional
+// (`WHERE quantity > 0`) dentro da transação e checando rows-affected, já
+// que o writer lock da transação serializa checkouts concorrentes do mesmo
+// livro e só um deles consegue afetar a linha.
+func (s *sqliteStore) Checkout(userID, bookID string, maxActiveLoans int, due time.Time) (Loan, error) {
+	var loan Loan
+	err := withTx(s.db, func(tx *sql.Tx) error {
+		var activeLoans int
+		if err := tx.QueryRow("SELECT COUNT(*) FROM loans WHERE user_id = ? AND returned_at IS NULL", userID).
+			Scan(&activeLoans); err != nil {
+			return err
+		}
+		if activeLoans >= maxActiveLoans {
+			return ErrLoanCapExceeded
+		}
+
+		result, err := tx.Exec("UPDATE books SET quantity = quantity - 1 WHERE id = ? AND quantity > 0", bookID)
+		if err != nil {
+			return err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			var existingID string
+			if err := tx.QueryRow("SELECT id FROM books WHERE id = ?", bookID).Scan(&existingID); err != nil {
+				if err == sql.ErrNoRows {
+					return ErrNotFound
+				}
+				return err
+			}
+			return ErrNoStock
+		}
+
+		loan = Loan{
+			ID:         uuid.NewString(),
+			UserID:     userID,
+			BookID:     bookID,
+			CheckoutAt: time.Now().UTC(),
+			DueAt:      due,
+		}
+		_, err = tx.Exec("INSERT INTO loans(id, user_id, book_id, checkout_at, due_at) VALUES (?, ?, ?, ?, ?)",
+			loan.ID, loan.UserID, loan.BookID, loan.CheckoutAt, loan.DueAt)
+		return err
+	})
+	if err != nil {
+		return Loan{}, err
+	}
+	return loan, nil
+}
+
+// Return marca loanID como devolvido e incrementa a quantidade do livro
+// correspondente em uma única transação (mesmo padrão de withTx do Checkout).
+func (s *sqliteStore) Return(loanID string) (Loan, error) {
+	var loan Loan
+	err := withTx(s.db, func(tx *sql.Tx) error {
+		var err error
+		loan, err = scanLoan(tx.QueryRow(
+			"SELECT id, user_id, book_id, checkout_at, due_at, returned_at FROM loans WHERE id = ?", loanID))
+		if err != nil {
+			return err
+		}
+		if loan.ReturnedAt != nil {
+			return ErrAlreadyReturned
+		}
+
+		returnedAt := time.Now().UTC()
+		if _, err := tx.Exec("UPDATE loans SET returned_at = ? WHERE id = ?", returnedAt, loanID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("UPDATE books SET quantity = quantity + 1 WHERE id = ?", loan.BookID); err != nil {
+			return err
+		}
+		loan.ReturnedAt = &returnedAt
+		return nil
+	})
+	if err != nil {
+		return Loan{}, err
+	}
+	return loan, nil
+}
+
+// scanLoan faz o Scan de uma linha de loans em um Loan, traduzindo
+// sql.ErrNoRows para ErrNotFound.
+func scanLoan(row *sql.Row) (Loan, error) {
+	var l Loan
+	var returnedAt sql.NullTime
+	if err := row.Scan(&l.ID, &l.UserID, &l.BookID, &l.CheckoutAt, &l.DueAt, &returnedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return Loan{}, ErrNotFound
+		}
+		return Loan{}, err
+	}
+	if returnedAt.Valid {
+		l.ReturnedAt = &returnedAt.Time
+	}
+	return l, nil
+}
+
+func (s *sqliteStore) GetLoan(id string) (Loan, error) {
+	return scanLoan(s.db.QueryRow(
+		"SELECT id, user_id, book_id, checkout_at, due_at, returned_at FROM loans WHERE id = ?", id))
+}
+
+func (s *sqliteStore) queryLoans(query string, args ...any) ([]Loan, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loans []Loan
+	for rows.Next() {
+		var l Loan
+		var returnedAt sql.NullTime
+		if err := rows.Scan(&l.ID, &l.UserID, &l.BookID, &l.CheckoutAt, &l.DueAt, &returnedAt); err != nil {
+			return nil, err
+		}
+		if returnedAt.Valid {
+			l.ReturnedAt = &returnedAt.Time
+		}
+		loans = append(loans, l)
+	}
+	return loans, rows.Err()
+}
+
+func (s *sqliteStore) GetAllLoans() ([]Loan, error) {
+	return s.queryLoans("SELECT id, user_id, book_id, checkout_at, due_at, returned_at FROM loans")
+}
+
+func (s *sqliteStore) GetOverdueLoans(now time.Time) ([]Loan, error) {
+	return s.queryLoans(
+		"SELECT id, user_id, book_id, checkout_at, due_at, returned_at FROM loans WHERE returned_at IS NULL AND due_at < ?",
+		now)
+}
+
+func (s *sqliteStore) GetLoansByUser(userID string) ([]Loan, error) {
+	return s.queryLoans(
+		"SELECT id, user_id, book_id, checkout_at, due_at, returned_at FROM loans WHERE user_id = ?", userID)
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestSQLiteCheckoutConcurrentStress dispara N goroutines fazendo checkout do
+// mesmo livro simultaneamente e garante que nunca mais cópias são
+// emprestadas do que a quantidade cadastrada, provando que o decremento em
+// Checkout está livre da race TOCTOU entre leitura e UPDATE.
+func TestSQLiteCheckoutConcurrentStress(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "stress.db")
+	s, err := newSQLiteStore(dsn)
+	if err != nil {
+		t.Fatalf("newSQLiteStore: %v", err)
+	}
+
+	const quantity = 10
+	const attempts = 50
+
+	if _, err := s.Create(Book{ID: "book-1", Title: "Stress Test", Quantity: quantity}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	successes := 0
+	due := time.Now().UTC().Add(14 * 24 * time.Hour)
+
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			userID := "user-" + string(rune('a'+i%26)) + "-" + string(rune('0'+i/26))
+			if _, err := s.(*sqliteStore).CreateUser(User{ID: userID, Name: userID}); err != nil && err != ErrConflict {
+				t.Errorf("CreateUser: %v", err)
+				return
+			}
+			if _, err := s.Checkout(userID, "book-1", attempts, due); err == nil {
+				mu.Lock()
+				successes++
+				mu.Unlock()
+			} else if err != ErrNoStock {
+				t.Errorf("unexpected Checkout error: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if successes != quantity {
+		t.Fatalf("got %d successful checkouts, want exactly %d", successes, quantity)
+	}
+
+	got, err := s.Get("book-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Quantity != 0 {
+		t.Fatalf("book-1 quantity = %d, want 0", got.Quantity)
+	}
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+// TestSQLiteMigrateLegacyBooks simula um books.db criado antes do catálogo de
+// autores/editoras (só id/title/quantity/author) e garante que newSQLiteStore
+// adiciona as colunas novas e converte a coluna author em um Author ligado ao
+// livro via author_books, sem perder os dados já cadastrados.
+func TestSQLiteMigrateLegacyBooks(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "legacy.db")
+
+	legacyDB, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	if _, err := legacyDB.Exec(`CREATE TABLE books (
+		id TEXT PRIMARY KEY,
+		title TEXT,
+		quantity INTEGER,
+		author TEXT
+	)`); err != nil {
+		t.Fatalf("create legacy table: %v", err)
+	}
+	if _, err := legacyDB.Exec(
+		"INSERT INTO books(id, title, quantity, author) VALUES (?, ?, ?, ?)",
+		"book-1", "Legacy Title", 3, "Legacy Author"); err != nil {
+		t.Fatalf("insert legacy row: %v", err)
+	}
+	if err := legacyDB.Close(); err != nil {
+		t.Fatalf("close legacy db: %v", err)
+	}
+
+	s, err := newSQLiteStore(dsn)
+	if err != nil {
+		t.Fatalf("newSQLiteStore: %v", err)
+	}
+
+	got, err := s.Get("book-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Title != "Legacy Title" || got.Quantity != 3 {
+		t.Fatalf("got %+v, want existing title/quantity preserved", got)
+	}
+	if len(got.Authors) != 1 || got.Authors[0].Name != "Legacy Author" {
+		t.Fatalf("got Authors = %+v, want a single author named %q", got.Authors, "Legacy Author")
+	}
+}
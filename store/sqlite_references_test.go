@@ -0,0 +1,111 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestSQLiteCreateRejectsDanglingReferences garante que sqliteStore recusa as
+// mesmas referências penduradas que memoryStore recusa (ver
+// TestMemoryStoreCreateRejectsDanglingReferences), em vez de deixar vazar o
+// erro bruto de FOREIGN KEY constraint failed do driver.
+func TestSQLiteCreateRejectsDanglingReferences(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "refs.db")
+	s, err := newSQLiteStore(dsn)
+	if err != nil {
+		t.Fatalf("newSQLiteStore: %v", err)
+	}
+
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", AuthorIDs: []string{"does-not-exist"}}); err != ErrInvalidReference {
+		t.Fatalf("got err %v, want ErrInvalidReference for dangling AuthorIDs", err)
+	}
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", PublisherID: "does-not-exist"}); err != ErrInvalidReference {
+		t.Fatalf("got err %v, want ErrInvalidReference for dangling PublisherID", err)
+	}
+}
+
+// TestSQLiteDeleteBookWithAuthorAndPublisher garante que Delete remove um
+// livro com autor e editora associados em vez de falhar com o erro bruto do
+// driver "FOREIGN KEY constraint failed" por causa das linhas em
+// author_books.
+func TestSQLiteDeleteBookWithAuthorAndPublisher(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "refs.db")
+	s, err := newSQLiteStore(dsn)
+	if err != nil {
+		t.Fatalf("newSQLiteStore: %v", err)
+	}
+
+	if _, err := s.CreateAuthor(Author{ID: "author-1", Name: "Frank Herbert"}); err != nil {
+		t.Fatalf("CreateAuthor: %v", err)
+	}
+	if _, err := s.CreatePublisher(Publisher{ID: "pub-1", Name: "Chilton Books"}); err != nil {
+		t.Fatalf("CreatePublisher: %v", err)
+	}
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", PublisherID: "pub-1", AuthorIDs: []string{"author-1"}}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if err := s.Delete("book-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Get("book-1"); err != ErrNotFound {
+		t.Fatalf("got err %v, want ErrNotFound after Delete", err)
+	}
+}
+
+// TestSQLiteDeleteAuthorRejectsWhileReferenced garante que DeleteAuthor
+// recusa remover um autor ainda em uso, em vez de deixar vazar o erro bruto
+// do driver.
+func TestSQLiteDeleteAuthorRejectsWhileReferenced(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "refs.db")
+	s, err := newSQLiteStore(dsn)
+	if err != nil {
+		t.Fatalf("newSQLiteStore: %v", err)
+	}
+
+	if _, err := s.CreateAuthor(Author{ID: "author-1", Name: "Frank Herbert"}); err != nil {
+		t.Fatalf("CreateAuthor: %v", err)
+	}
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", AuthorIDs: []string{"author-1"}}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if err := s.DeleteAuthor("author-1"); err != ErrReferenced {
+		t.Fatalf("got err %v, want ErrReferenced while book-1 still references author-1", err)
+	}
+
+	if err := s.Delete("book-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := s.DeleteAuthor("author-1"); err != nil {
+		t.Fatalf("DeleteAuthor after book removed: %v", err)
+	}
+}
+
+// TestSQLiteDeletePublisherRejectsWhileReferenced espelha
+// TestSQLiteDeleteAuthorRejectsWhileReferenced para DeletePublisher.
+func TestSQLiteDeletePublisherRejectsWhileReferenced(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "refs.db")
+	s, err := newSQLiteStore(dsn)
+	if err != nil {
+		t.Fatalf("newSQLiteStore: %v", err)
+	}
+
+	if _, err := s.CreatePublisher(Publisher{ID: "pub-1", Name: "Chilton Books"}); err != nil {
+		t.Fatalf("CreatePublisher: %v", err)
+	}
+	if _, err := s.Create(Book{ID: "book-1", Title: "Duna", PublisherID: "pub-1"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if err := s.DeletePublisher("pub-1"); err != ErrReferenced {
+		t.Fatalf("got err %v, want ErrReferenced while book-1 still references pub-1", err)
+	}
+
+	if err := s.Delete("book-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := s.DeletePublisher("pub-1"); err != nil {
+		t.Fatalf("DeletePublisher after book removed: %v", err)
+	}
+}
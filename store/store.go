@@ -0,0 +1,162 @@
+// Package store define a abstração de persistência usada pela API, de forma
+// que os handlers HTTP não conheçam detalhes do banco de dados por trás deles.
+package store
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Book é o modelo de um livro independente do backend de armazenamento.
+// AuthorIDs referencia os autores na tabela author_books; Authors e Publisher
+// vêm preenchidos (hidratados) por Get e GetAll a partir desses IDs.
+type Book struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Quantity    int        `json:"quantity"`
+	PublisherID string     `json:"publisher_id,omitempty"`
+	Publisher   *Publisher `json:"publisher,omitempty"`
+	AuthorIDs   []string   `json:"author_ids,omitempty"`
+	Authors     []Author   `json:"authors,omitempty"`
+	ISBN        string     `json:"isbn,omitempty"`
+	Pages       int        `json:"pages,omitempty"`
+	CoverURL    string     `json:"cover_url,omitempty"`
+	Description string     `json:"description,omitempty"`
+}
+
+// Author é um autor de livro, cadastrado separadamente e referenciado por
+// Book.AuthorIDs através da tabela de junção author_books.
+type Author struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Publisher é a editora responsável por um livro.
+type Publisher struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// User é um usuário que pode pegar livros emprestados.
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Loan é um registro do empréstimo (checkout) de um livro por um usuário.
+// ReturnedAt é nil enquanto o livro não é devolvido.
+type Loan struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	BookID     string     `json:"book_id"`
+	CheckoutAt time.Time  `json:"checkout_at"`
+	DueAt      time.Time  `json:"due_at"`
+	ReturnedAt *time.Time `json:"returned_at,omitempty"`
+}
+
+// Erros sentinela retornados pelas implementações de Store.
+var (
+	ErrNotFound         = errors.New("recurso não encontrado")
+	ErrConflict         = errors.New("id já existe")
+	ErrInvalidReference = errors.New("autor ou editora referenciados não existem")
+	ErrReferenced       = errors.New("recurso ainda referenciado por um livro")
+	ErrNoStock          = errors.New("livro não disponível")
+	ErrLoanCapExceeded  = errors.New("limite de empréstimos simultâneos atingido")
+	ErrAlreadyReturned  = errors.New("empréstimo já foi devolvido")
+)
+
+// Store é a interface que qualquer backend de persistência precisa implementar.
+type Store interface {
+	// Close libera os recursos do backend (ex.: a conexão com o banco).
+	// Implementações sem recursos a liberar (como memoryStore) retornam nil.
+	Close() error
+
+	// Create insere um novo livro, retornando ErrConflict se o ID já existir
+	// e ErrInvalidReference se PublisherID ou algum de AuthorIDs não existir.
+	Create(b Book) (Book, error)
+	// Update substitui os dados de um livro existente, com a mesma validação
+	// de referências de Create.
+	Update(b Book) error
+	// Get busca um livro pelo ID (com Authors e Publisher hidratados),
+	// retornando ErrNotFound se não existir.
+	Get(id string) (Book, error)
+	// GetAll retorna todos os livros cadastrados, já hidratados.
+	GetAll() ([]Book, error)
+	// Delete remove um livro pelo ID e suas associações de autor.
+	Delete(id string) error
+
+	// CreateAuthor insere um novo autor, retornando ErrConflict se o ID já existir.
+	CreateAuthor(a Author) (Author, error)
+	// GetAuthor busca um autor pelo ID.
+	GetAuthor(id string) (Author, error)
+	// GetAllAuthors retorna todos os autores cadastrados.
+	GetAllAuthors() ([]Author, error)
+	// UpdateAuthor substitui os dados de um autor existente.
+	UpdateAuthor(a Author) error
+	// DeleteAuthor remove um autor pelo ID, retornando ErrReferenced se algum
+	// livro ainda o referenciar.
+	DeleteAuthor(id string) error
+
+	// CreatePublisher insere uma nova editora, retornando ErrConflict se o ID já existir.
+	CreatePublisher(p Publisher) (Publisher, error)
+	// GetPublisher busca uma editora pelo ID.
+	GetPublisher(id string) (Publisher, error)
+	// GetAllPublishers retorna todas as editoras cadastradas.
+	GetAllPublishers() ([]Publisher, error)
+	// UpdatePublisher substitui os dados de uma editora existente.
+	UpdatePublisher(p Publisher) error
+	// DeletePublisher remove uma editora pelo ID, retornando ErrReferenced se
+	// algum livro ainda a referenciar.
+	DeletePublisher(id string) error
+
+	// CreateUser insere um novo usuário, retornando ErrConflict se o ID já existir.
+	CreateUser(u User) (User, error)
+	// GetUser busca um usuário pelo ID.
+	GetUser(id string) (User, error)
+	// GetAllUsers retorna todos os usuários cadastrados.
+	GetAllUsers() ([]User, error)
+	// UpdateUser substitui os dados de um usuário existente.
+	UpdateUser(u User) error
+	// DeleteUser remove um usuário pelo ID.
+	DeleteUser(id string) error
+
+	// Checkout empresta bookID para userID até due, em uma única transação
+	// que decrementa a quantidade do livro e insere o registro de empréstimo.
+	// Retorna ErrLoanCapExceeded se userID já tiver maxActiveLoans empréstimos
+	// em aberto, e ErrNoStock se não houver exemplares disponíveis.
+	Checkout(userID, bookID string, maxActiveLoans int, due time.Time) (Loan, error)
+	// Return marca o empréstimo loanID como devolvido e incrementa a
+	// quantidade do livro correspondente, em uma única transação. Retorna
+	// ErrAlreadyReturned se o empréstimo já tiver sido devolvido.
+	Return(loanID string) (Loan, error)
+	// GetLoan busca um empréstimo pelo ID.
+	GetLoan(id string) (Loan, error)
+	// GetAllLoans retorna todos os empréstimos.
+	GetAllLoans() ([]Loan, error)
+	// GetOverdueLoans retorna os empréstimos com due_at no passado e ainda
+	// não devolvidos.
+	GetOverdueLoans(now time.Time) ([]Loan, error)
+	// GetLoansByUser retorna todos os empréstimos de um usuário.
+	GetLoansByUser(userID string) ([]Loan, error)
+}
+
+// Factory cria uma Store a partir de uma string de conexão (DSN).
+type Factory func(dsn string) (Store, error)
+
+var registry = make(map[string]Factory)
+
+// Register torna uma Factory disponível com o nome informado. É pensado para
+// ser chamado a partir de func init() de cada implementação de Store.
+func Register(name string, factory Factory) {
+	registry[name] = factory
+}
+
+// New constrói a Store registrada sob name, repassando dsn para sua Factory.
+func New(name, dsn string) (Store, error) {
+	factory, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("store: provider desconhecido: %q", name)
+	}
+	return factory(dsn)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/GuadalupePradoSR/Book-Library-API/store"
+)
+
+// função para cadastrar um novo usuário.
+func createUser(c *gin.Context) {
+	var newUser store.User
+	if err := c.BindJSON(&newUser); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+
+	created, err := st.CreateUser(newUser)
+	if err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "ID do usuário já existe"})
+			return
+		}
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao adicionar usuário: %v", err)})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, created)
+}
+
+// função para retornar todos os usuários.
+func getUsers(c *gin.Context) {
+	users, err := st.GetAllUsers()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao buscar usuários"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, users)
+}
+
+// função para buscar um usuário pelo ID.
+func getUser(c *gin.Context) {
+	u, err := st.GetUser(c.Param("id"))
+	if err != nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Usuário não encontrado"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, u)
+}
+
+// função para atualizar os dados de um usuário existente.
+func updateUser(c *gin.Context) {
+	var u store.User
+	if err := c.BindJSON(&u); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Dados inválidos"})
+		return
+	}
+	u.ID = c.Param("id")
+
+	if err := st.UpdateUser(u); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Usuário não encontrado"})
+			return
+		}
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao atualizar usuário: %v", err)})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, u)
+}
+
+// função para remover um usuário.
+func deleteUser(c *gin.Context) {
+	if err := st.DeleteUser(c.Param("id")); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Usuário não encontrado"})
+			return
+		}
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("Erro ao remover usuário: %v", err)})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// função para listar os empréstimos de um usuário.
+func getUserLoans(c *gin.Context) {
+	id := c.Param("id")
+	if _, err := st.GetUser(id); err != nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Usuário não encontrado"})
+		return
+	}
+
+	loans, err := st.GetLoansByUser(id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Erro ao buscar empréstimos"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, loans)
+}